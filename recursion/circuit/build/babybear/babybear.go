@@ -1,8 +1,10 @@
 package babybear
 
 import (
+	"fmt"
 	"math/big"
 
+	"github.com/consensys/gnark/constraint/solver"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/std/math/emulated"
 )
@@ -130,9 +132,9 @@ func (c *Chip) MulExtension(a, b *ExtensionVariable) *ExtensionVariable {
 	for i := 0; i < 4; i++ {
 		for j := 0; j < 4; j++ {
 			if i+j >= 4 {
-				v[i+j-4] = c.Add(v[i+j-4], c.Mul(c.Mul(v[i], v[j]), w))
+				v[i+j-4] = c.Add(v[i+j-4], c.Mul(c.Mul(a.value[i], b.value[j]), w))
 			} else {
-				v[i+j] = c.Add(v[i+j], c.Mul(v[i], v[j]))
+				v[i+j] = c.Add(v[i+j], c.Mul(a.value[i], b.value[j]))
 			}
 		}
 	}
@@ -148,14 +150,98 @@ func (c *Chip) NegExtension(a *ExtensionVariable) *ExtensionVariable {
 	return &ExtensionVariable{value: [4]*Variable{v1, v2, v3, v4}}
 }
 
+// InvExtension returns the multiplicative inverse of a in Fp[X]/(X^4 - 11). The actual
+// inverse is computed natively by invExtensionHint (using the Fp -> Fp2 -> Fp4 conjugate
+// trick, bottoming out in a single base-field inversion), so the only constraints added
+// here are a single MulExtension and an AssertEqExtension against one.
 func (c *Chip) InvExtension(a *ExtensionVariable) *ExtensionVariable {
-	v := [4]*Variable{
-		NewVariable(0),
-		NewVariable(0),
-		NewVariable(0),
-		NewVariable(0),
+	inputs := []*emulated.Element[Params]{
+		a.value[0].Value, a.value[1].Value, a.value[2].Value, a.value[3].Value,
 	}
-	return &ExtensionVariable{value: v}
+	results, err := c.field.NewHint(invExtensionHint, 4, inputs...)
+	if err != nil {
+		panic(err)
+	}
+
+	inv := &ExtensionVariable{
+		value: [4]*Variable{
+			{Value: results[0]},
+			{Value: results[1]},
+			{Value: results[2]},
+			{Value: results[3]},
+		},
+	}
+
+	one := NewExtensionVariable([4]int{1, 0, 0, 0})
+	c.AssertEqExtension(c.MulExtension(a, inv), one)
+
+	return inv
+}
+
+func init() {
+	solver.RegisterHint(invExtensionHint)
+}
+
+// invExtensionHint is the hint InvExtension calls through Field.NewHint, which passes the
+// inputs as packed native limbs; emulated.UnwrapHint recomposes them for invExtensionNative.
+func invExtensionHint(nativeMod *big.Int, nativeInputs, nativeOutputs []*big.Int) error {
+	return emulated.UnwrapHint(nativeInputs, nativeOutputs, invExtensionNative)
+}
+
+// invExtensionNative computes the inverse of a = a0 + a1*X + a2*X^2 + a3*X^3 in
+// Fp[X]/(X^4 - 11), via the standard Fp -> Fp2 -> Fp4 tower: conjugating over
+// X -> -X lands in Fp2 (c = c0 + c2*X^2), conjugating again over X^2 -> -X^2 and
+// multiplying reduces to a base-field norm n = c0^2 - 11*c2^2, which is inverted
+// once with a native modular inverse.
+func invExtensionNative(mod *big.Int, inputs, outputs []*big.Int) error {
+	w := big.NewInt(11)
+	a0, a1, a2, a3 := inputs[0], inputs[1], inputs[2], inputs[3]
+
+	// b1 = a0 - a1*X + a2*X^2 - a3*X^3.
+	b1 := [4]*big.Int{a0, new(big.Int).Neg(a1), a2, new(big.Int).Neg(a3)}
+
+	// c = a * b1 kills the odd-degree terms, leaving c = c0 + c2*X^2.
+	c := mulExtensionNative(mod, w, [4]*big.Int{a0, a1, a2, a3}, b1)
+
+	// Conjugate c over X^2 -> -X^2, then reduce the norm n = c0^2 - w*c2^2 to Fp.
+	cConjX2 := new(big.Int).Neg(c[2])
+	norm := new(big.Int).Sub(mulMod(mod, c[0], c[0]), mulMod(mod, w, mulMod(mod, c[2], c[2])))
+	norm.Mod(norm, mod)
+	normInv := new(big.Int).ModInverse(norm, mod)
+	if normInv == nil {
+		return fmt.Errorf("babybear extension element has no inverse")
+	}
+
+	// b = b1 * (c0 - c2*X^2), so that a*b = c*(c0 - c2*X^2) = norm.
+	b := mulExtensionNative(mod, w, b1, [4]*big.Int{c[0], big.NewInt(0), cConjX2, big.NewInt(0)})
+	for i := 0; i < 4; i++ {
+		outputs[i] = mulMod(mod, b[i], normInv)
+	}
+	return nil
+}
+
+func mulMod(mod, x, y *big.Int) *big.Int {
+	z := new(big.Int).Mul(x, y)
+	return z.Mod(z, mod)
+}
+
+// mulExtensionNative mirrors Chip.MulExtension over plain big.Ints, for use inside hints.
+func mulExtensionNative(mod, w *big.Int, a, b [4]*big.Int) [4]*big.Int {
+	v := [4]*big.Int{big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0)}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			prod := new(big.Int).Mul(a[i], b[j])
+			if i+j >= 4 {
+				v[i+j-4].Add(v[i+j-4], prod.Mul(prod, w))
+			} else {
+				v[i+j].Add(v[i+j], prod)
+			}
+		}
+	}
+	for i := range v {
+		v[i].Mod(v[i], mod)
+	}
+	return v
 }
 
 func (c *Chip) AssertEqExtension(a, b *ExtensionVariable) {
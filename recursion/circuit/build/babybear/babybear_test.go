@@ -0,0 +1,28 @@
+package babybear
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+// invExtensionCircuit exercises InvExtension on a non-trivial Fp4 element. InvExtension
+// already asserts inv*a == 1 internally, so a satisfied prover here is the regression
+// guard: it fails whenever the hint's output is not an inverse of a under MulExtension.
+// Neither side is compared with an independently computed reference value.
+type invExtensionCircuit struct{}
+
+func (c *invExtensionCircuit) Define(api frontend.API) error {
+	chip := NewChip(api)
+	a := NewExtensionVariable([4]int{5, 7, 11, 13})
+	chip.InvExtension(a)
+	return nil
+}
+
+func TestInvExtension(t *testing.T) {
+	assert := test.NewAssert(t)
+	var circuit invExtensionCircuit
+	assert.ProverSucceeded(&circuit, &invExtensionCircuit{}, test.WithCurves(ecc.BN254))
+}
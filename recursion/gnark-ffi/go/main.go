@@ -8,26 +8,56 @@ typedef struct {
 	char *EncodedProof;
 	char *RawProof;
 } C_PlonkBn254Proof;
+
+typedef struct {
+	char *EncodedProof;
+	char *RawProof;
+} C_Bls12377RecursiveProof;
+
+typedef struct {
+	char *PublicInputs[2];
+	char *EncodedProof;
+	char *RawProof;
+} C_Groth16Bn254Proof;
 */
 import "C"
 import (
+	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"sync"
 
 	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/backend/groth16"
 	"github.com/consensys/gnark/backend/plonk"
 	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
 	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
 	"github.com/consensys/gnark/test/unsafekzg"
 	"github.com/succinctlabs/sp1-recursion-gnark/sp1"
 	"github.com/succinctlabs/sp1-recursion-gnark/sp1/babybear"
 	"github.com/succinctlabs/sp1-recursion-gnark/sp1/poseidon2"
+	"github.com/succinctlabs/sp1-recursion-gnark/sp1/recursion"
+	"github.com/succinctlabs/sp1-recursion-gnark/sp1/srs"
 )
 
 func main() {}
 
+// srsDataDir is where the memoized SRS (see sp1/srs) is cached for the cgo self-tests
+// below, which have no dataDir argument of their own.
+func srsDataDir() string {
+	if dir := os.Getenv("SP1_CIRCUIT_DATA_DIR"); dir != "" {
+		return dir
+	}
+	return "."
+}
+
 //export ProvePlonkBn254
 func ProvePlonkBn254(dataDir *C.char, witnessPath *C.char) *C.C_PlonkBn254Proof {
 	dataDirString := C.GoString(dataDir)
@@ -70,6 +100,259 @@ func VerifyPlonkBn254(dataDir *C.char, proof *C.char, vkeyHash *C.char, commited
 	return nil
 }
 
+// recursiveCircuit is the SP1 BN254 PLONK proof recursively verified inside a circuit
+// compiled over BLS12-377, using gnark's non-native sw_bn254 group arithmetic gadgets.
+type recursiveCircuit = recursion.Circuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]
+
+//export ProveRecursiveBn254InBls12377
+func ProveRecursiveBn254InBls12377(dataDir *C.char) *C.C_Bls12377RecursiveProof {
+	dataDirString := C.GoString(dataDir)
+
+	sp1Proof, sp1Vk, publicInputs, err := sp1.LoadPlonkProof(dataDirString)
+	if err != nil {
+		return nil
+	}
+
+	assignment, err := recursion.NewCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl](sp1Proof, sp1Vk, publicInputs)
+	if err != nil {
+		return nil
+	}
+
+	circuit, err := recursion.NewCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl](sp1Proof, sp1Vk, publicInputs)
+	if err != nil {
+		return nil
+	}
+	circuit.Proof = recursion.PlaceholderProof[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine](sp1Vk)
+
+	builder := scs.NewBuilder
+	outerScs, err := frontend.Compile(ecc.BLS12_377.ScalarField(), builder, &circuit)
+	if err != nil {
+		return nil
+	}
+
+	// Load the SRS: a real Powers-of-Tau transcript, unless the insecure escape hatch is
+	// explicitly requested (SP1_UNSAFE_SRS=1, tests only). This is the on-chain-aggregation
+	// proving path, so it must never fall back to unsafekzg outside of tests.
+	var outerPk plonk.ProvingKey
+	if srs.Unsafe() {
+		kzgSRS, kzgSRSLagrange, err := unsafekzg.NewSRS(outerScs)
+		if err != nil {
+			return nil
+		}
+		if outerPk, _, err = plonk.Setup(outerScs, kzgSRS, kzgSRSLagrange); err != nil {
+			return nil
+		}
+	} else {
+		kzgSRS, kzgSRSLagrange, err := srs.Load(dataDirString, os.Getenv("SP1_PTAU_PATH"), uint64(outerScs.GetNbConstraints()))
+		if err != nil {
+			return nil
+		}
+		if outerPk, _, err = plonk.Setup(outerScs, kzgSRS, kzgSRSLagrange); err != nil {
+			return nil
+		}
+	}
+
+	witness, err := frontend.NewWitness(&assignment, ecc.BLS12_377.ScalarField())
+	if err != nil {
+		return nil
+	}
+
+	outerProof, err := plonk.Prove(outerScs, outerPk, witness)
+	if err != nil {
+		return nil
+	}
+
+	ms := C.malloc(C.sizeof_C_Bls12377RecursiveProof)
+	if ms == nil {
+		return nil
+	}
+	structPtr := (*C.C_Bls12377RecursiveProof)(ms)
+	encodedProof, err := sp1.EncodePlonkProof(outerProof)
+	if err != nil {
+		return nil
+	}
+	structPtr.EncodedProof = C.CString(encodedProof)
+	structPtr.RawProof = C.CString(outerProof.(fmt.Stringer).String())
+	return structPtr
+}
+
+// The Groth16 backend shares sp1.NewCircuit and the witness JSON format with the PLONK
+// backend above, but keeps its own proving/verifying key and proof under dataDir so the
+// two backends never collide on disk.
+func groth16PkPath(dataDir string) string    { return filepath.Join(dataDir, "groth16_pk.bin") }
+func groth16VkPath(dataDir string) string    { return filepath.Join(dataDir, "groth16_vk.bin") }
+func groth16ProofPath(dataDir string) string { return filepath.Join(dataDir, "groth16_proof.json") }
+
+//export BuildGroth16Bn254
+func BuildGroth16Bn254(dataDir *C.char) {
+	dataDirString := C.GoString(dataDir)
+
+	circuit := sp1.NewCircuit(sp1.WitnessInput{})
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &circuit)
+	if err != nil {
+		return
+	}
+
+	pk, vk, err := groth16.Setup(ccs)
+	if err != nil {
+		return
+	}
+
+	if err := writeTo(groth16PkPath(dataDirString), pk); err != nil {
+		return
+	}
+	writeTo(groth16VkPath(dataDirString), vk)
+}
+
+//export ProveGroth16Bn254
+func ProveGroth16Bn254(dataDir *C.char, witnessPath *C.char) *C.C_Groth16Bn254Proof {
+	dataDirString := C.GoString(dataDir)
+	witnessPathString := C.GoString(witnessPath)
+
+	data, err := os.ReadFile(witnessPathString)
+	if err != nil {
+		return nil
+	}
+	var inputs sp1.WitnessInput
+	if err := json.Unmarshal(data, &inputs); err != nil {
+		return nil
+	}
+
+	circuit := sp1.NewCircuit(inputs)
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &circuit)
+	if err != nil {
+		return nil
+	}
+
+	var pk groth16.ProvingKey = groth16.NewProvingKey(ecc.BN254)
+	if err := readFrom(groth16PkPath(dataDirString), pk); err != nil {
+		return nil
+	}
+
+	assignment := sp1.NewCircuit(inputs)
+	witness, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField())
+	if err != nil {
+		return nil
+	}
+
+	proof, err := groth16.Prove(ccs, pk, witness)
+	if err != nil {
+		return nil
+	}
+	if err := writeTo(groth16ProofPath(dataDirString), proof); err != nil {
+		return nil
+	}
+
+	publicWitness, err := witness.Public()
+	if err != nil {
+		return nil
+	}
+	publicValues, ok := publicWitness.Vector().(fr.Vector)
+	if !ok || len(publicValues) < 2 {
+		return nil
+	}
+
+	ms := C.malloc(C.sizeof_C_Groth16Bn254Proof)
+	if ms == nil {
+		return nil
+	}
+	structPtr := (*C.C_Groth16Bn254Proof)(ms)
+	structPtr.PublicInputs[0] = C.CString(publicValues[0].String())
+	structPtr.PublicInputs[1] = C.CString(publicValues[1].String())
+	structPtr.EncodedProof = C.CString(hexEncode(proof))
+	structPtr.RawProof = C.CString(fmt.Sprintf("%+v", proof))
+	return structPtr
+}
+
+//export VerifyGroth16Bn254
+func VerifyGroth16Bn254(dataDir *C.char, proof *C.char, vkeyHash *C.char, commitedValuesDigest *C.char) *C.char {
+	dataDirString := C.GoString(dataDir)
+	proofString := C.GoString(proof)
+
+	var vk groth16.VerifyingKey = groth16.NewVerifyingKey(ecc.BN254)
+	if err := readFrom(groth16VkPath(dataDirString), vk); err != nil {
+		return C.CString(err.Error())
+	}
+
+	proofBytes, err := hex.DecodeString(proofString)
+	if err != nil {
+		return C.CString(fmt.Sprintf("decode proof: %s", err))
+	}
+	var loadedProof groth16.Proof = groth16.NewProof(ecc.BN254)
+	if _, err := loadedProof.ReadFrom(bytes.NewReader(proofBytes)); err != nil {
+		return C.CString(fmt.Sprintf("deserialize proof: %s", err))
+	}
+
+	vkeyHashString := C.GoString(vkeyHash)
+	commitedValuesDigestString := C.GoString(commitedValuesDigest)
+	publicAssignment := sp1.PublicInputsCircuit(vkeyHashString, commitedValuesDigestString)
+	publicWitness, err := frontend.NewWitness(&publicAssignment, ecc.BN254.ScalarField(), frontend.PublicOnly())
+	if err != nil {
+		return C.CString(err.Error())
+	}
+
+	if err := groth16.Verify(loadedProof, vk, publicWitness); err != nil {
+		return C.CString(err.Error())
+	}
+	return nil
+}
+
+// ExportSolidityVerifier writes a Solidity verifier contract for the given backend
+// ("plonk" or "groth16") built from dataDir, to outPath.
+//
+//export ExportSolidityVerifier
+func ExportSolidityVerifier(dataDir *C.char, backend *C.char, outPath *C.char) *C.char {
+	dataDirString := C.GoString(dataDir)
+	backendString := C.GoString(backend)
+	outPathString := C.GoString(outPath)
+
+	b, err := sp1.BackendByName(backendString)
+	if err != nil {
+		return C.CString(err.Error())
+	}
+
+	out, err := os.Create(outPathString)
+	if err != nil {
+		return C.CString(err.Error())
+	}
+	defer out.Close()
+
+	if err := sp1.ExportSolidityVerifier(dataDirString, b, out); err != nil {
+		return C.CString(err.Error())
+	}
+	return nil
+}
+
+// writeTo serializes v (a gnark io.WriterTo, e.g. a proving/verifying key or proof) to path.
+func writeTo(path string, v io.WriterTo) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = v.WriteTo(f)
+	return err
+}
+
+// readFrom deserializes v (a gnark io.ReaderFrom) from path.
+func readFrom(path string, v io.ReaderFrom) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = v.ReadFrom(f)
+	return err
+}
+
+func hexEncode(v io.WriterTo) string {
+	var buf bytes.Buffer
+	if _, err := v.WriteTo(&buf); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(buf.Bytes())
+}
+
 var testMutex = &sync.Mutex{}
 
 //export TestPlonkBn254
@@ -117,15 +400,25 @@ func TestMain() error {
 	}
 	fmt.Println("[sp1] gnark verifier constraints:", scs.GetNbConstraints())
 
-	// Run the dummy setup.
-	srs, srsLagrange, err := unsafekzg.NewSRS(scs)
-	if err != nil {
-		return err
-	}
+	// Load the SRS: a real Powers-of-Tau transcript, unless the insecure escape hatch is
+	// explicitly requested (SP1_UNSAFE_SRS=1, tests only).
 	var pk plonk.ProvingKey
-	pk, _, err = plonk.Setup(scs, srs, srsLagrange)
-	if err != nil {
-		return err
+	if srs.Unsafe() {
+		kzgSRS, kzgSRSLagrange, err := unsafekzg.NewSRS(scs)
+		if err != nil {
+			return err
+		}
+		if pk, _, err = plonk.Setup(scs, kzgSRS, kzgSRSLagrange); err != nil {
+			return err
+		}
+	} else {
+		kzgSRS, kzgSRSLagrange, err := srs.Load(srsDataDir(), os.Getenv("SP1_PTAU_PATH"), uint64(scs.GetNbConstraints()))
+		if err != nil {
+			return err
+		}
+		if pk, _, err = plonk.Setup(scs, kzgSRS, kzgSRSLagrange); err != nil {
+			return err
+		}
 	}
 
 	// Generate witness.
@@ -193,15 +486,25 @@ func TestPoseidonBabyBear2() *C.char {
 		return C.CString(err.Error())
 	}
 
-	// Run the dummy setup.
-	srs, srsLagrange, err := unsafekzg.NewSRS(scs)
-	if err != nil {
-		return C.CString(err.Error())
-	}
+	// Load the SRS: a real Powers-of-Tau transcript, unless the insecure escape hatch is
+	// explicitly requested (SP1_UNSAFE_SRS=1, tests only).
 	var pk plonk.ProvingKey
-	pk, _, err = plonk.Setup(scs, srs, srsLagrange)
-	if err != nil {
-		return C.CString(err.Error())
+	if srs.Unsafe() {
+		kzgSRS, kzgSRSLagrange, err := unsafekzg.NewSRS(scs)
+		if err != nil {
+			return C.CString(err.Error())
+		}
+		if pk, _, err = plonk.Setup(scs, kzgSRS, kzgSRSLagrange); err != nil {
+			return C.CString(err.Error())
+		}
+	} else {
+		kzgSRS, kzgSRSLagrange, err := srs.Load(srsDataDir(), os.Getenv("SP1_PTAU_PATH"), uint64(scs.GetNbConstraints()))
+		if err != nil {
+			return C.CString(err.Error())
+		}
+		if pk, _, err = plonk.Setup(scs, kzgSRS, kzgSRSLagrange); err != nil {
+			return C.CString(err.Error())
+		}
 	}
 
 	// Generate witness.
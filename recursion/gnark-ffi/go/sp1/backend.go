@@ -0,0 +1,109 @@
+// Package sp1 drives the gnark circuits, proving/verifying keys, and proofs that back
+// SP1's PLONK and Groth16 BN254 backends, as invoked through the cgo FFI in
+// recursion/gnark-ffi/go/main.go.
+package sp1
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/plonk"
+)
+
+// VerifyingKey is the subset of groth16.VerifyingKey and plonk.VerifyingKey that
+// ExportSolidityVerifier needs, letting it work across both backends without caring which
+// one it was handed.
+type VerifyingKey interface {
+	ExportSolidity(w io.Writer) error
+}
+
+// Backend is satisfied by each proving system SP1's gnark FFI can target (PLONK, Groth16),
+// so operations like ExportSolidityVerifier can be written once against the interface
+// instead of switching on a backend name inline.
+type Backend interface {
+	// Name identifies the backend, e.g. "plonk" or "groth16".
+	Name() string
+	// LoadVerifyingKey reads this backend's verifying key back from dataDir.
+	LoadVerifyingKey(dataDir string) (VerifyingKey, error)
+}
+
+type plonkBackend struct{}
+
+// Plonk is the Backend for SP1's PLONK-over-BN254 proofs.
+var Plonk Backend = plonkBackend{}
+
+func (plonkBackend) Name() string { return "plonk" }
+
+func (plonkBackend) LoadVerifyingKey(dataDir string) (VerifyingKey, error) {
+	var vk plonk.VerifyingKey = plonk.NewVerifyingKey(ecc.BN254)
+	if err := readFrom(VerifyingKeyPath(dataDir), vk); err != nil {
+		return nil, err
+	}
+	return vk, nil
+}
+
+type groth16Backend struct{}
+
+// Groth16 is the Backend for SP1's Groth16-over-BN254 proofs.
+var Groth16 Backend = groth16Backend{}
+
+func (groth16Backend) Name() string { return "groth16" }
+
+func (groth16Backend) LoadVerifyingKey(dataDir string) (VerifyingKey, error) {
+	var vk groth16.VerifyingKey = groth16.NewVerifyingKey(ecc.BN254)
+	if err := readFrom(filepath.Join(dataDir, "groth16_vk.bin"), vk); err != nil {
+		return nil, err
+	}
+	return vk, nil
+}
+
+// BackendByName resolves the Backend named "plonk" or "groth16", as used by the
+// ExportSolidityVerifier cgo entry point to turn a C string into a Backend.
+func BackendByName(name string) (Backend, error) {
+	switch name {
+	case "plonk":
+		return Plonk, nil
+	case "groth16":
+		return Groth16, nil
+	default:
+		return nil, fmt.Errorf("unknown backend %q", name)
+	}
+}
+
+// ExportSolidityVerifier writes a Solidity verifier contract for backend's proving system,
+// loaded from dataDir, to out.
+func ExportSolidityVerifier(dataDir string, backend Backend, out io.Writer) error {
+	vk, err := backend.LoadVerifyingKey(dataDir)
+	if err != nil {
+		return fmt.Errorf("load %s verifying key: %w", backend.Name(), err)
+	}
+	return vk.ExportSolidity(out)
+}
+
+// readFrom deserializes v (a gnark io.ReaderFrom, e.g. a verifying key) from path.
+func readFrom(path string, v interface {
+	ReadFrom(r io.Reader) (int64, error)
+}) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = v.ReadFrom(f)
+	return err
+}
+
+// writeTo serializes v (a gnark io.WriterTo, e.g. a proving/verifying key) to path.
+func writeTo(path string, v io.WriterTo) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = v.WriteTo(f)
+	return err
+}
@@ -0,0 +1,23 @@
+package sp1
+
+import "github.com/consensys/gnark/frontend"
+
+// publicInputsCircuit mirrors the 2 public inputs every SP1 BN254 circuit (PLONK or
+// Groth16) exposes, in order: the vkey hash, then the committed values digest.
+type publicInputsCircuit struct {
+	VkeyHash             frontend.Variable
+	CommitedValuesDigest frontend.Variable
+}
+
+func (c *publicInputsCircuit) Define(frontend.API) error { return nil }
+
+// PublicInputsCircuit returns the public-only witness assignment for vkeyHash and
+// commitedValuesDigest (decimal BN254 field element strings, the same encoding
+// fr.Element.String() produces), for reconstructing the public witness a Verify entry
+// point needs without the rest of the (secret) SP1 witness input.
+func PublicInputsCircuit(vkeyHash, commitedValuesDigest string) publicInputsCircuit {
+	return publicInputsCircuit{
+		VkeyHash:             vkeyHash,
+		CommitedValuesDigest: commitedValuesDigest,
+	}
+}
@@ -0,0 +1,128 @@
+package sp1
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/backend/plonk"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/test/unsafekzg"
+
+	"github.com/succinctlabs/sp1-recursion-gnark/sp1/srs"
+)
+
+// ProvingKeyPath returns where Build persists the PLONK proving key under dataDir.
+func ProvingKeyPath(dataDir string) string {
+	return filepath.Join(dataDir, "plonk_pk.bin")
+}
+
+// PlonkBn254Proof is Prove's return value: an SP1 BN254 PLONK proof in the shapes the cgo
+// layer hands back across the FFI boundary.
+type PlonkBn254Proof struct {
+	PublicInputs [2]string
+	EncodedProof string
+	RawProof     string
+}
+
+// Build compiles the SP1 PLONK verifier circuit and runs PLONK setup against a real
+// Powers-of-Tau SRS, persisting the proving/verifying keys under dataDir for Prove and
+// Verify to use later. It panics on any compile/setup failure, the same way NewChip does
+// for an unrecoverable one-time setup step.
+func Build(dataDir string) {
+	circuit := NewCircuit(WitnessInput{})
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, &circuit)
+	if err != nil {
+		panic(fmt.Errorf("compile circuit: %w", err))
+	}
+
+	// Load the SRS: a real Powers-of-Tau transcript, unless the insecure escape hatch is
+	// explicitly requested (SP1_UNSAFE_SRS=1, tests only). This is the everyday PLONK
+	// build path every real SP1 deployment calls, so it must never fall back to
+	// unsafekzg outside of tests.
+	var pk plonk.ProvingKey
+	var vk plonk.VerifyingKey
+	if srs.Unsafe() {
+		kzgSRS, kzgSRSLagrange, err := unsafekzg.NewSRS(ccs)
+		if err != nil {
+			panic(fmt.Errorf("unsafekzg SRS: %w", err))
+		}
+		if pk, vk, err = plonk.Setup(ccs, kzgSRS, kzgSRSLagrange); err != nil {
+			panic(fmt.Errorf("plonk setup: %w", err))
+		}
+	} else {
+		kzgSRS, kzgSRSLagrange, err := srs.Load(dataDir, os.Getenv("SP1_PTAU_PATH"), uint64(ccs.GetNbConstraints()))
+		if err != nil {
+			panic(fmt.Errorf("load SRS: %w", err))
+		}
+		if pk, vk, err = plonk.Setup(ccs, kzgSRS, kzgSRSLagrange); err != nil {
+			panic(fmt.Errorf("plonk setup: %w", err))
+		}
+	}
+
+	if err := writeTo(ProvingKeyPath(dataDir), pk); err != nil {
+		panic(fmt.Errorf("write proving key: %w", err))
+	}
+	if err := writeTo(VerifyingKeyPath(dataDir), vk); err != nil {
+		panic(fmt.Errorf("write verifying key: %w", err))
+	}
+}
+
+// Prove builds a PLONK proof for the witness at witnessPath, using the proving key Build
+// persisted under dataDir. It panics on any failure, matching Build.
+func Prove(dataDir, witnessPath string) PlonkBn254Proof {
+	data, err := os.ReadFile(witnessPath)
+	if err != nil {
+		panic(fmt.Errorf("read witness: %w", err))
+	}
+	var inputs WitnessInput
+	if err := json.Unmarshal(data, &inputs); err != nil {
+		panic(fmt.Errorf("unmarshal witness: %w", err))
+	}
+
+	circuit := NewCircuit(inputs)
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, &circuit)
+	if err != nil {
+		panic(fmt.Errorf("compile circuit: %w", err))
+	}
+
+	var pk plonk.ProvingKey = plonk.NewProvingKey(ecc.BN254)
+	if err := readFrom(ProvingKeyPath(dataDir), pk); err != nil {
+		panic(fmt.Errorf("read proving key: %w", err))
+	}
+
+	assignment := NewCircuit(inputs)
+	witness, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField())
+	if err != nil {
+		panic(fmt.Errorf("new witness: %w", err))
+	}
+
+	proof, err := plonk.Prove(ccs, pk, witness)
+	if err != nil {
+		panic(fmt.Errorf("plonk prove: %w", err))
+	}
+
+	publicWitness, err := witness.Public()
+	if err != nil {
+		panic(fmt.Errorf("public witness: %w", err))
+	}
+	publicValues, ok := publicWitness.Vector().(fr.Vector)
+	if !ok || len(publicValues) < 2 {
+		panic(fmt.Errorf("unexpected public witness shape"))
+	}
+
+	encodedProof, err := EncodePlonkProof(proof)
+	if err != nil {
+		panic(fmt.Errorf("encode proof: %w", err))
+	}
+
+	return PlonkBn254Proof{
+		PublicInputs: [2]string{publicValues[0].String(), publicValues[1].String()},
+		EncodedProof: encodedProof,
+		RawProof:     fmt.Sprintf("%+v", proof),
+	}
+}
@@ -46,31 +46,28 @@ func (p *Poseidon2BabyBearChip) PermuteMut(state *[BABYBEAR_WIDTH]babybear.Varia
 	p.externalLinearLayer(state)
 
 	// The first half of the external rounds.
-	// rounds := BABYBEAR_NUM_EXTERNAL_ROUNDS + BABYBEAR_NUM_INTERNAL_ROUNDS
+	rounds := BABYBEAR_NUM_EXTERNAL_ROUNDS + BABYBEAR_NUM_INTERNAL_ROUNDS
 	roundsFBeggining := BABYBEAR_NUM_EXTERNAL_ROUNDS / 2
 	for r := 0; r < roundsFBeggining; r++ {
 		p.addRc(state, RC16[r])
 		p.sbox(state)
 		p.externalLinearLayer(state)
-		if r == 0 {
-			break
-		}
 	}
 
-	// // The internal rounds.
-	// p_end := roundsFBeggining + BABYBEAR_NUM_INTERNAL_ROUNDS
-	// for r := roundsFBeggining; r < p_end; r++ {
-	// 	state[0] = p.fieldApi.AddF(state[0], RC16[r][0])
-	// 	state[0] = p.sboxP(state[0])
-	// 	p.diffusionPermuteMut(state)
-	// }
-
-	// // The second half of the external rounds.
-	// for r := p_end; r < rounds; r++ {
-	// 	p.addRc(state, RC16[r])
-	// 	p.sbox(state)
-	// 	p.matrixPermuteMut(state)
-	// }
+	// The internal rounds.
+	p_end := roundsFBeggining + BABYBEAR_NUM_INTERNAL_ROUNDS
+	for r := roundsFBeggining; r < p_end; r++ {
+		state[0] = p.fieldApi.AddF(state[0], RC16[r][0])
+		state[0] = p.sboxP(state[0])
+		p.diffusionPermuteMut(state)
+	}
+
+	// The second half of the external rounds.
+	for r := p_end; r < rounds; r++ {
+		p.addRc(state, RC16[r])
+		p.sbox(state)
+		p.externalLinearLayer(state)
+	}
 }
 
 func (p *Poseidon2BabyBearChip) addRc(state *[BABYBEAR_WIDTH]babybear.Variable, rc [BABYBEAR_WIDTH]babybear.Variable) {
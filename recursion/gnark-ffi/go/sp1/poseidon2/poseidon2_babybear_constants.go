@@ -0,0 +1,31 @@
+package poseidon2
+
+import "github.com/succinctlabs/sp1-recursion-gnark/sp1/babybear"
+
+// RC16 holds the round constants for the BabyBear width-16 Poseidon2 permutation,
+// one row per round (the first BABYBEAR_NUM_EXTERNAL_ROUNDS/2 rows are consumed by the
+// first half of external rounds, the next BABYBEAR_NUM_INTERNAL_ROUNDS by the internal
+// rounds, and the remainder by the second half of external rounds).
+var RC16 = [BABYBEAR_NUM_EXTERNAL_ROUNDS + BABYBEAR_NUM_INTERNAL_ROUNDS][BABYBEAR_WIDTH]babybear.Variable{
+	{babybear.NewF("2004070125"), babybear.NewF("1583208281"), babybear.NewF("1571181924"), babybear.NewF("210870756"), babybear.NewF("603911150"), babybear.NewF("1406160171"), babybear.NewF("891304020"), babybear.NewF("1124227120"), babybear.NewF("1796810169"), babybear.NewF("664544441"), babybear.NewF("437085958"), babybear.NewF("1681474324"), babybear.NewF("635320532"), babybear.NewF("1898981557"), babybear.NewF("1897108049"), babybear.NewF("806750523")},
+	{babybear.NewF("827558963"), babybear.NewF("252188666"), babybear.NewF("1803584417"), babybear.NewF("706994162"), babybear.NewF("328323710"), babybear.NewF("749055005"), babybear.NewF("471349378"), babybear.NewF("21827743"), babybear.NewF("597787967"), babybear.NewF("1643537868"), babybear.NewF("822341127"), babybear.NewF("404363848"), babybear.NewF("1256515647"), babybear.NewF("1165277791"), babybear.NewF("282972990"), babybear.NewF("34204777")},
+	{babybear.NewF("1343892757"), babybear.NewF("1513809019"), babybear.NewF("613478939"), babybear.NewF("364654833"), babybear.NewF("1941335527"), babybear.NewF("1714741295"), babybear.NewF("1757780455"), babybear.NewF("1368812888"), babybear.NewF("1914395688"), babybear.NewF("287632713"), babybear.NewF("1532976996"), babybear.NewF("206729542"), babybear.NewF("1630813905"), babybear.NewF("603830659"), babybear.NewF("489265505"), babybear.NewF("652069784")},
+	{babybear.NewF("264540830"), babybear.NewF("331073212"), babybear.NewF("1874845505"), babybear.NewF("47239091"), babybear.NewF("1883346182"), babybear.NewF("1290314371"), babybear.NewF("1965249400"), babybear.NewF("97648553"), babybear.NewF("1539636326"), babybear.NewF("1592161082"), babybear.NewF("105158780"), babybear.NewF("248038148"), babybear.NewF("1350989243"), babybear.NewF("1304026059"), babybear.NewF("1275857977"), babybear.NewF("1852477890")},
+	{babybear.NewF("1058093805"), babybear.NewF("931054314"), babybear.NewF("211964854"), babybear.NewF("1218505811"), babybear.NewF("1536461785"), babybear.NewF("862840587"), babybear.NewF("1196238979"), babybear.NewF("1178215270"), babybear.NewF("1914861804"), babybear.NewF("147550630"), babybear.NewF("1141494220"), babybear.NewF("1424144209"), babybear.NewF("1106084982"), babybear.NewF("200297188"), babybear.NewF("7623576"), babybear.NewF("948613449")},
+	{babybear.NewF("1648291831"), babybear.NewF("1835874212"), babybear.NewF("1749429405"), babybear.NewF("1729504849"), babybear.NewF("1514764898"), babybear.NewF("849954519"), babybear.NewF("94362286"), babybear.NewF("139286713"), babybear.NewF("406196743"), babybear.NewF("613352338"), babybear.NewF("726789673"), babybear.NewF("1851205611"), babybear.NewF("472225978"), babybear.NewF("137067012"), babybear.NewF("1246971045"), babybear.NewF("633074434")},
+	{babybear.NewF("510515046"), babybear.NewF("1789427549"), babybear.NewF("266335638"), babybear.NewF("501306217"), babybear.NewF("1106430231"), babybear.NewF("586471914"), babybear.NewF("480315062"), babybear.NewF("979373132"), babybear.NewF("310907086"), babybear.NewF("1051637583"), babybear.NewF("773207710"), babybear.NewF("1194035898"), babybear.NewF("1709379659"), babybear.NewF("1418134431"), babybear.NewF("881717334"), babybear.NewF("712813074")},
+	{babybear.NewF("1724116028"), babybear.NewF("1598635982"), babybear.NewF("1402430991"), babybear.NewF("1509213273"), babybear.NewF("1325612504"), babybear.NewF("1809621220"), babybear.NewF("60478731"), babybear.NewF("989545220"), babybear.NewF("1334209866"), babybear.NewF("1120708439"), babybear.NewF("693205065"), babybear.NewF("591030801"), babybear.NewF("1686349202"), babybear.NewF("252428423"), babybear.NewF("837150325"), babybear.NewF("879595178")},
+	{babybear.NewF("1678292300"), babybear.NewF("870042583"), babybear.NewF("387684524"), babybear.NewF("72549682"), babybear.NewF("711835121"), babybear.NewF("524158120"), babybear.NewF("1773328794"), babybear.NewF("973923109"), babybear.NewF("925110940"), babybear.NewF("62371223"), babybear.NewF("1118831099"), babybear.NewF("1970626525"), babybear.NewF("144517778"), babybear.NewF("1230081445"), babybear.NewF("610647110"), babybear.NewF("1888898701")},
+	{babybear.NewF("696729345"), babybear.NewF("1665967782"), babybear.NewF("1205937696"), babybear.NewF("762665872"), babybear.NewF("861536130"), babybear.NewF("1771080757"), babybear.NewF("87016141"), babybear.NewF("824246038"), babybear.NewF("908144117"), babybear.NewF("620890419"), babybear.NewF("1786423894"), babybear.NewF("124935909"), babybear.NewF("1342695939"), babybear.NewF("1946703772"), babybear.NewF("166644747"), babybear.NewF("460234290")},
+	{babybear.NewF("272806973"), babybear.NewF("462226401"), babybear.NewF("936380163"), babybear.NewF("888908609"), babybear.NewF("1729747887"), babybear.NewF("688952125"), babybear.NewF("1521475170"), babybear.NewF("1395675805"), babybear.NewF("1026282204"), babybear.NewF("531876958"), babybear.NewF("19666882"), babybear.NewF("1557927016"), babybear.NewF("641176169"), babybear.NewF("9848979"), babybear.NewF("1234405705"), babybear.NewF("548631257")},
+	{babybear.NewF("521968795"), babybear.NewF("221402059"), babybear.NewF("1239988993"), babybear.NewF("1817073847"), babybear.NewF("76319742"), babybear.NewF("1709038890"), babybear.NewF("172163799"), babybear.NewF("980252985"), babybear.NewF("728217367"), babybear.NewF("1153793442"), babybear.NewF("977765383"), babybear.NewF("1072543078"), babybear.NewF("321822990"), babybear.NewF("188805969"), babybear.NewF("383685102"), babybear.NewF("696712476")},
+	{babybear.NewF("1489574870"), babybear.NewF("1193011806"), babybear.NewF("1032860420"), babybear.NewF("1370459511"), babybear.NewF("381178104"), babybear.NewF("232740851"), babybear.NewF("839019615"), babybear.NewF("57199202"), babybear.NewF("572001451"), babybear.NewF("294708134"), babybear.NewF("2005240416"), babybear.NewF("845004323"), babybear.NewF("1040483801"), babybear.NewF("974573372"), babybear.NewF("1259530705"), babybear.NewF("558391806")},
+	{babybear.NewF("1993147800"), babybear.NewF("1446384726"), babybear.NewF("849231995"), babybear.NewF("1331914032"), babybear.NewF("1508712937"), babybear.NewF("1205516048"), babybear.NewF("166831340"), babybear.NewF("1016986507"), babybear.NewF("480778635"), babybear.NewF("1360961822"), babybear.NewF("1722064898"), babybear.NewF("1913788686"), babybear.NewF("1256669863"), babybear.NewF("546164649"), babybear.NewF("130316101"), babybear.NewF("1112236851")},
+	{babybear.NewF("260535990"), babybear.NewF("1111092678"), babybear.NewF("39926526"), babybear.NewF("636739333"), babybear.NewF("1016163371"), babybear.NewF("520034264"), babybear.NewF("16905503"), babybear.NewF("1781058066"), babybear.NewF("1142229864"), babybear.NewF("1683209233"), babybear.NewF("215703509"), babybear.NewF("608462484"), babybear.NewF("999551648"), babybear.NewF("1927327304"), babybear.NewF("1373694451"), babybear.NewF("70334771")},
+	{babybear.NewF("1560113603"), babybear.NewF("1984200741"), babybear.NewF("62806489"), babybear.NewF("1692513025"), babybear.NewF("747130597"), babybear.NewF("240701194"), babybear.NewF("475231619"), babybear.NewF("295807764"), babybear.NewF("1500533192"), babybear.NewF("1169775333"), babybear.NewF("389346209"), babybear.NewF("968101549"), babybear.NewF("1810754249"), babybear.NewF("111059400"), babybear.NewF("838485237"), babybear.NewF("1220901493")},
+	{babybear.NewF("905243542"), babybear.NewF("1858440309"), babybear.NewF("1123119871"), babybear.NewF("539770078"), babybear.NewF("1819782851"), babybear.NewF("109451628"), babybear.NewF("1597150066"), babybear.NewF("391522737"), babybear.NewF("362959351"), babybear.NewF("1533081835"), babybear.NewF("1289846640"), babybear.NewF("8041297"), babybear.NewF("1386349220"), babybear.NewF("289033206"), babybear.NewF("1037356781"), babybear.NewF("667544513")},
+	{babybear.NewF("809179996"), babybear.NewF("1883347157"), babybear.NewF("12120623"), babybear.NewF("1446231625"), babybear.NewF("716017327"), babybear.NewF("332722476"), babybear.NewF("440213743"), babybear.NewF("1367837428"), babybear.NewF("1690405902"), babybear.NewF("1773657460"), babybear.NewF("443035326"), babybear.NewF("1902370765"), babybear.NewF("441300469"), babybear.NewF("305476063"), babybear.NewF("239636530"), babybear.NewF("289255117")},
+	{babybear.NewF("1308361472"), babybear.NewF("1941135725"), babybear.NewF("1653442133"), babybear.NewF("161701077"), babybear.NewF("1174359413"), babybear.NewF("30663765"), babybear.NewF("919803941"), babybear.NewF("135627375"), babybear.NewF("1237504295"), babybear.NewF("271115264"), babybear.NewF("1691386804"), babybear.NewF("1249746260"), babybear.NewF("488301039"), babybear.NewF("1601682581"), babybear.NewF("827369953"), babybear.NewF("1187896906")},
+	{babybear.NewF("998354535"), babybear.NewF("485333430"), babybear.NewF("1512340121"), babybear.NewF("1734116369"), babybear.NewF("1458434219"), babybear.NewF("91526240"), babybear.NewF("866073332"), babybear.NewF("1146064561"), babybear.NewF("1042160046"), babybear.NewF("34702025"), babybear.NewF("1917111442"), babybear.NewF("1602057018"), babybear.NewF("741994692"), babybear.NewF("993413166"), babybear.NewF("1565743383"), babybear.NewF("1068939031")},
+	{babybear.NewF("912052111"), babybear.NewF("1430233827"), babybear.NewF("764427410"), babybear.NewF("1948718798"), babybear.NewF("1546890587"), babybear.NewF("1230083252"), babybear.NewF("476169033"), babybear.NewF("1163228375"), babybear.NewF("1119345298"), babybear.NewF("1487883585"), babybear.NewF("449593360"), babybear.NewF("864517538"), babybear.NewF("1371400335"), babybear.NewF("1887939432"), babybear.NewF("313948385"), babybear.NewF("46629146")},
+}
@@ -0,0 +1,74 @@
+package poseidon2
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+	"github.com/succinctlabs/sp1-recursion-gnark/sp1/babybear"
+)
+
+// permuteCircuit asserts that PermuteMut(Input) == ExpectedOutput, so the RC16 table and
+// the external/internal round structure are exercised by `go test` rather than only
+// through the cgo-exported TestPoseidonBabyBear2.
+type permuteCircuit struct {
+	Input          [BABYBEAR_WIDTH]babybear.Variable
+	ExpectedOutput [BABYBEAR_WIDTH]babybear.Variable
+}
+
+func (c *permuteCircuit) Define(api frontend.API) error {
+	fieldApi := babybear.NewChip(api)
+	state := c.Input
+	NewPoseidon2BabyBearChip(api).PermuteMut(&state)
+	for i := range state {
+		fieldApi.AssertEq(&state[i], &c.ExpectedOutput[i])
+	}
+	return nil
+}
+
+// TestPermuteMut pins the permutation of a fixed input against an output independently
+// recomputed (outside gnark, over plain BabyBear field arithmetic) from this exact RC16
+// table and round structure, so a change to either is caught here.
+func TestPermuteMut(t *testing.T) {
+	assert := test.NewAssert(t)
+	input := [BABYBEAR_WIDTH]babybear.Variable{
+		babybear.NewF("894848333"),
+		babybear.NewF("1437655012"),
+		babybear.NewF("1200606629"),
+		babybear.NewF("1690012884"),
+		babybear.NewF("71131202"),
+		babybear.NewF("1749206695"),
+		babybear.NewF("1717947831"),
+		babybear.NewF("120589055"),
+		babybear.NewF("19776022"),
+		babybear.NewF("42382981"),
+		babybear.NewF("1831865506"),
+		babybear.NewF("724844064"),
+		babybear.NewF("171220207"),
+		babybear.NewF("1299207443"),
+		babybear.NewF("227047920"),
+		babybear.NewF("1783754913"),
+	}
+	expectedOutput := [BABYBEAR_WIDTH]babybear.Variable{
+		babybear.NewF("512585766"),
+		babybear.NewF("975869435"),
+		babybear.NewF("1921378527"),
+		babybear.NewF("1238606951"),
+		babybear.NewF("899635794"),
+		babybear.NewF("132650430"),
+		babybear.NewF("1426417547"),
+		babybear.NewF("1734425242"),
+		babybear.NewF("57415409"),
+		babybear.NewF("67173027"),
+		babybear.NewF("1535042492"),
+		babybear.NewF("1318033394"),
+		babybear.NewF("1070659233"),
+		babybear.NewF("17258943"),
+		babybear.NewF("856719028"),
+		babybear.NewF("1500534995"),
+	}
+
+	circuit := permuteCircuit{Input: input, ExpectedOutput: expectedOutput}
+	assert.ProverSucceeded(&circuit, &permuteCircuit{Input: input, ExpectedOutput: expectedOutput}, test.WithCurves(ecc.BN254))
+}
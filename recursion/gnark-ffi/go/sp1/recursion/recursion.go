@@ -0,0 +1,140 @@
+// Package recursion lets a downstream circuit recursively verify an SP1 PLONK-over-BN254
+// proof. It mirrors the shape of gnark's std/recursion/plonk package: the proof and
+// verifying key are wrapped as generic, non-native types parameterized over the outer
+// circuit's scalar field and curve points, so a circuit compiled over a different curve
+// (e.g. BLS12-377, to recurse over a BN254 SP1 proof) can embed an in-circuit verifier for
+// it without touching the inner SP1 constraint system.
+package recursion
+
+import (
+	"fmt"
+	"math/big"
+
+	native_plonk "github.com/consensys/gnark/backend/plonk"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/recursion/plonk"
+	"github.com/succinctlabs/sp1-recursion-gnark/sp1/babybear"
+)
+
+// Proof wraps an SP1 BN254 PLONK proof as a non-native witness value usable inside an
+// outer circuit over FR/G1El/G2El.
+type Proof[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT] struct {
+	Inner plonk.Proof[FR, G1El, G2El]
+}
+
+// VerifyingKey wraps an SP1 BN254 PLONK verifying key the same way Proof wraps the proof.
+type VerifyingKey[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT] struct {
+	Inner plonk.VerifyingKey[FR, G1El, G2El]
+}
+
+// ValueOfProof converts a concrete SP1 BN254 plonk.Proof into the non-native witness
+// assignment for Proof.
+func ValueOfProof[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT](proof native_plonk.Proof) (Proof[FR, G1El, G2El], error) {
+	inner, err := plonk.ValueOfProof[FR, G1El, G2El](proof)
+	if err != nil {
+		return Proof[FR, G1El, G2El]{}, fmt.Errorf("value of SP1 proof: %w", err)
+	}
+	return Proof[FR, G1El, G2El]{Inner: inner}, nil
+}
+
+// ValueOfVerifyingKey converts a concrete SP1 BN254 plonk.VerifyingKey into the non-native
+// witness assignment for VerifyingKey.
+func ValueOfVerifyingKey[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT](vk native_plonk.VerifyingKey) (VerifyingKey[FR, G1El, G2El], error) {
+	inner, err := plonk.ValueOfVerifyingKey[FR, G1El, G2El](vk)
+	if err != nil {
+		return VerifyingKey[FR, G1El, G2El]{}, fmt.Errorf("value of SP1 verifying key: %w", err)
+	}
+	return VerifyingKey[FR, G1El, G2El]{Inner: inner}, nil
+}
+
+// PlaceholderProof returns a correctly-shaped zero value of Proof for the given verifying
+// key, for use as the recursive circuit's unassigned witness during compilation.
+func PlaceholderProof[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT](vk native_plonk.VerifyingKey) Proof[FR, G1El, G2El] {
+	return Proof[FR, G1El, G2El]{Inner: plonk.PlaceholderProof[FR, G1El, G2El](vk)}
+}
+
+// Circuit is the outer recursive circuit: it embeds an SP1 BN254 proof/vkey pair as
+// non-native witnesses and asserts the proof verifies. The SP1 public values (vkey hash,
+// committed values digest) travel as part of Witness, the same way plonk.Witness binds any
+// other PLONK public input. NewCircuit also fills VkeyHash/CommitedValuesDigest with those
+// values split into BabyBear limbs; Define does not constrain these two fields, so nothing
+// in the circuit ties them to Witness.
+type Circuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GtEl algebra.GtElementT] struct {
+	Proof   Proof[FR, G1El, G2El]
+	Vk      VerifyingKey[FR, G1El, G2El] `gnark:"-"`
+	Witness plonk.Witness[FR]
+
+	VkeyHash             [3]babybear.Variable
+	CommitedValuesDigest [3]babybear.Variable
+}
+
+// NewCircuit builds the Circuit witness assignment for recursively verifying the SP1
+// BN254 proof that sp1.Prove produced against an sp1.WitnessInput. publicInputs holds the
+// proof's two public inputs (vkey hash, then committed values digest) as decimal BN254
+// field element strings.
+func NewCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GtEl algebra.GtElementT](
+	proof native_plonk.Proof,
+	vk native_plonk.VerifyingKey,
+	publicInputs []string,
+) (Circuit[FR, G1El, G2El, GtEl], error) {
+	if len(publicInputs) < 2 {
+		return Circuit[FR, G1El, G2El, GtEl]{}, fmt.Errorf("expected 2 SP1 public inputs, got %d", len(publicInputs))
+	}
+
+	proofVal, err := ValueOfProof[FR, G1El, G2El](proof)
+	if err != nil {
+		return Circuit[FR, G1El, G2El, GtEl]{}, err
+	}
+	vkVal, err := ValueOfVerifyingKey[FR, G1El, G2El](vk)
+	if err != nil {
+		return Circuit[FR, G1El, G2El, GtEl]{}, err
+	}
+	witness, err := plonk.ValueOfWitness[FR](publicInputs)
+	if err != nil {
+		return Circuit[FR, G1El, G2El, GtEl]{}, fmt.Errorf("value of SP1 public inputs: %w", err)
+	}
+	vkeyHash, err := splitIntoBabyBear(publicInputs[0])
+	if err != nil {
+		return Circuit[FR, G1El, G2El, GtEl]{}, fmt.Errorf("split vkey hash: %w", err)
+	}
+	commitedValuesDigest, err := splitIntoBabyBear(publicInputs[1])
+	if err != nil {
+		return Circuit[FR, G1El, G2El, GtEl]{}, fmt.Errorf("split commited values digest: %w", err)
+	}
+
+	return Circuit[FR, G1El, G2El, GtEl]{
+		Proof:                proofVal,
+		Vk:                   vkVal,
+		Witness:              witness,
+		VkeyHash:             vkeyHash,
+		CommitedValuesDigest: commitedValuesDigest,
+	}, nil
+}
+
+// splitIntoBabyBear splits the low 96 bits of a decimal BN254 field element string into 3
+// 32-bit BabyBear limbs, the same packing babybear.Chip.SplitIntoBabyBear produces
+// in-circuit.
+func splitIntoBabyBear(value string) ([3]babybear.Variable, error) {
+	v, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return [3]babybear.Variable{}, fmt.Errorf("parse babybear-packed value %q", value)
+	}
+
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 32), big.NewInt(1))
+	var limbs [3]babybear.Variable
+	for i := range limbs {
+		limb := new(big.Int).And(new(big.Int).Rsh(v, uint(32*i)), mask)
+		limbs[i] = babybear.NewF(limb.String())
+	}
+	return limbs, nil
+}
+
+func (c *Circuit[FR, G1El, G2El, GtEl]) Define(api frontend.API) error {
+	verifier, err := plonk.NewVerifier[FR, G1El, G2El, GtEl](api)
+	if err != nil {
+		return fmt.Errorf("new SP1 recursive verifier: %w", err)
+	}
+	return verifier.AssertProof(c.Vk.Inner, c.Proof.Inner, c.Witness, plonk.WithCompleteArithmetic())
+}
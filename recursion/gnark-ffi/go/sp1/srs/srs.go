@@ -0,0 +1,319 @@
+// Package srs loads the BN254 KZG structured reference string SP1's PLONK backend needs,
+// so that production Build/Prove flows never fall back to gnark's unsafekzg.NewSRS, which
+// is explicitly documented as insecure and non-deterministic. It can import a Perpetual
+// Powers-of-Tau transcript (the snarkjs .ptau format) or a gnark-native serialized
+// kzg.SRS, derives the matching Lagrange-basis SRS via an inverse FFT over the monomial
+// G1 points, and memoizes both to disk so repeated Build/Prove calls for the same circuit
+// size don't redo the work.
+package srs
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"math/bits"
+	"os"
+	"path/filepath"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+)
+
+// UnsafeEnvVar gates the insecure unsafekzg fallback. It must only ever be set by tests.
+const UnsafeEnvVar = "SP1_UNSAFE_SRS"
+
+// Unsafe reports whether the SP1_UNSAFE_SRS escape hatch is set for this process.
+func Unsafe() bool {
+	return os.Getenv(UnsafeEnvVar) == "1"
+}
+
+// Load returns the monomial-basis and Lagrange-basis KZG SRS for a domain big enough to
+// hold size constraints, memoizing both under dataDir. ptauPath, if non-empty, points at a
+// Perpetual Powers-of-Tau transcript (snarkjs .ptau format) to import the monomial SRS
+// from the first time it's needed; afterwards the memoized copy in dataDir is used
+// instead. size is rounded up to the next power of two, matching the domain gnark's PLONK
+// backend actually constructs for a circuit with that many constraints; callers may pass
+// the raw constraint count.
+func Load(dataDir, ptauPath string, size uint64) (kzg.SRS, kzg.SRS, error) {
+	size = nextPowerOfTwo(size)
+
+	monomial, err := loadOrImportMonomial(dataDir, ptauPath, size)
+	if err != nil {
+		return kzg.SRS{}, kzg.SRS{}, fmt.Errorf("load monomial SRS: %w", err)
+	}
+
+	lagrange, err := loadOrDeriveLagrange(dataDir, monomial, size)
+	if err != nil {
+		return kzg.SRS{}, kzg.SRS{}, fmt.Errorf("derive Lagrange SRS: %w", err)
+	}
+
+	return monomial, lagrange, nil
+}
+
+func monomialPath(dataDir string, size uint64) string {
+	return filepath.Join(dataDir, fmt.Sprintf("srs_%d.bin", size))
+}
+
+func lagrangePath(dataDir string, size uint64) string {
+	return filepath.Join(dataDir, fmt.Sprintf("srs_lagrange_%d.bin", size))
+}
+
+func loadOrImportMonomial(dataDir, ptauPath string, size uint64) (kzg.SRS, error) {
+	var srs kzg.SRS
+	if f, err := os.Open(monomialPath(dataDir, size)); err == nil {
+		defer f.Close()
+		if _, err := srs.ReadFrom(bufio.NewReader(f)); err != nil {
+			return kzg.SRS{}, fmt.Errorf("read memoized SRS: %w", err)
+		}
+		return srs, nil
+	}
+
+	if ptauPath == "" {
+		return kzg.SRS{}, fmt.Errorf("no memoized SRS for size %d and no ptau path provided", size)
+	}
+
+	srs, err := importPtau(ptauPath, size)
+	if err != nil {
+		return kzg.SRS{}, fmt.Errorf("import ptau %q: %w", ptauPath, err)
+	}
+	if err := writeMemoized(monomialPath(dataDir, size), &srs); err != nil {
+		return kzg.SRS{}, fmt.Errorf("memoize SRS: %w", err)
+	}
+	return srs, nil
+}
+
+func loadOrDeriveLagrange(dataDir string, monomial kzg.SRS, size uint64) (kzg.SRS, error) {
+	var lagrange kzg.SRS
+	if f, err := os.Open(lagrangePath(dataDir, size)); err == nil {
+		defer f.Close()
+		if _, err := lagrange.ReadFrom(bufio.NewReader(f)); err != nil {
+			return kzg.SRS{}, fmt.Errorf("read memoized Lagrange SRS: %w", err)
+		}
+		return lagrange, nil
+	}
+
+	points := make([]bn254.G1Jac, size)
+	for i := range points {
+		points[i].FromAffine(&monomial.Pk.G1[i])
+	}
+	ifftG1(points, fft.NewDomain(size))
+
+	lagrange = monomial
+	lagrange.Pk.G1 = make([]bn254.G1Affine, size)
+	for i := range points {
+		lagrange.Pk.G1[i].FromJacobian(&points[i])
+	}
+
+	if err := writeMemoized(lagrangePath(dataDir, size), &lagrange); err != nil {
+		return kzg.SRS{}, fmt.Errorf("memoize Lagrange SRS: %w", err)
+	}
+	return lagrange, nil
+}
+
+// ifftG1 converts the monomial-basis SRS points into Lagrange basis in place, running the
+// same radix-2 decimation-in-time butterfly network as fft.Domain.FFTInverse, but with
+// point addition/subtraction standing in for field addition/subtraction and EC scalar
+// multiplication by domain roots standing in for field multiplication (the FFT is linear,
+// so it applies unchanged to any Z-module, including the additive group of G1 points).
+func ifftG1(points []bn254.G1Jac, domain *fft.Domain) {
+	n := uint64(len(points))
+	bitReverseG1(points)
+
+	for step := uint64(1); step < n; step <<= 1 {
+		w := domain.GeneratorInv
+		for s := step; s < n/2; s <<= 1 {
+			w.Square(&w)
+		}
+
+		for start := uint64(0); start < n; start += step * 2 {
+			wPow := fr.One()
+			for k := uint64(0); k < step; k++ {
+				var t bn254.G1Jac
+				t.ScalarMultiplication(&points[start+step+k], wPow.BigInt(new(big.Int)))
+
+				lo := points[start+k]
+				points[start+k].Set(&lo).AddAssign(&t)
+				points[start+step+k].Set(&lo).SubAssign(&t)
+				wPow.Mul(&wPow, &w)
+			}
+		}
+	}
+
+	var nInv fr.Element
+	nInv.SetUint64(n)
+	nInv.Inverse(&nInv)
+	nInvBig := new(big.Int)
+	nInv.BigInt(nInvBig)
+	for i := range points {
+		points[i].ScalarMultiplication(&points[i], nInvBig)
+	}
+}
+
+// nextPowerOfTwo rounds size up to the nearest power of two, or 1 if size is 0. ifftG1's
+// radix-2 butterfly network requires it; gnark's PLONK backend pads every circuit's
+// constraint system to a power-of-two domain for the same reason, so this matches the
+// domain size the backend will actually use.
+func nextPowerOfTwo(size uint64) uint64 {
+	if size <= 1 {
+		return 1
+	}
+	return uint64(1) << bits.Len64(size-1)
+}
+
+func bitReverseG1(points []bn254.G1Jac) {
+	n := uint64(len(points))
+	if n == 0 {
+		return
+	}
+	shift := uint(64 - bits.Len64(n-1))
+	for i := uint64(0); i < n; i++ {
+		j := bits.Reverse64(i) >> shift
+		if i < j {
+			points[i], points[j] = points[j], points[i]
+		}
+	}
+}
+
+func writeMemoized(path string, srs *kzg.SRS) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+	if _, err := srs.WriteTo(w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// importPtau reads a Perpetual-Powers-of-Tau transcript (snarkjs .ptau format: a 4-byte
+// "ptau" magic, a version, a section count, then one (id uint32, size uint64, data)
+// section per entry) and builds the monomial KZG SRS for a domain of the given size from
+// its tauG1/tauG2 sections.
+func importPtau(path string, size uint64) (kzg.SRS, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return kzg.SRS{}, err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+
+	var magic [4]byte
+	if _, err := io.ReadFull(r, magic[:]); err != nil {
+		return kzg.SRS{}, fmt.Errorf("read magic: %w", err)
+	}
+	if string(magic[:]) != "ptau" {
+		return kzg.SRS{}, fmt.Errorf("not a .ptau file (got magic %q)", magic)
+	}
+
+	var version, numSections uint32
+	if err := binary.Read(r, binary.LittleEndian, &version); err != nil {
+		return kzg.SRS{}, fmt.Errorf("read version: %w", err)
+	}
+	if err := binary.Read(r, binary.LittleEndian, &numSections); err != nil {
+		return kzg.SRS{}, fmt.Errorf("read section count: %w", err)
+	}
+
+	var srs kzg.SRS
+	for i := uint32(0); i < numSections; i++ {
+		var id uint32
+		var sectionSize uint64
+		if err := binary.Read(r, binary.LittleEndian, &id); err != nil {
+			return kzg.SRS{}, fmt.Errorf("read section %d id: %w", i, err)
+		}
+		if err := binary.Read(r, binary.LittleEndian, &sectionSize); err != nil {
+			return kzg.SRS{}, fmt.Errorf("read section %d size: %w", i, err)
+		}
+
+		switch id {
+		case 2: // tauG1
+			if err := readTauG1(r, sectionSize, size, &srs); err != nil {
+				return kzg.SRS{}, fmt.Errorf("read tauG1: %w", err)
+			}
+		case 3: // tauG2
+			if err := readTauG2(r, sectionSize, &srs); err != nil {
+				return kzg.SRS{}, fmt.Errorf("read tauG2: %w", err)
+			}
+		default:
+			if _, err := r.Discard(int(sectionSize)); err != nil {
+				return kzg.SRS{}, fmt.Errorf("skip section %d: %w", id, err)
+			}
+		}
+	}
+
+	return srs, nil
+}
+
+func readTauG1(r *bufio.Reader, sectionSize, size uint64, srs *kzg.SRS) error {
+	const g1Bytes = 64 // snarkjs serializes BN254 G1 points as two 32-byte field elements
+	n := sectionSize / g1Bytes
+	if n < size+3 {
+		return fmt.Errorf("tauG1 section has %d points, need at least %d for domain size %d", n, size+3, size)
+	}
+
+	srs.Pk.G1 = make([]bn254.G1Affine, size+3)
+	buf := make([]byte, g1Bytes)
+	for i := uint64(0); i < size+3; i++ {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return err
+		}
+		if err := srs.Pk.G1[i].X.SetBytesCanonical(buf[:32]); err != nil {
+			return err
+		}
+		if err := srs.Pk.G1[i].Y.SetBytesCanonical(buf[32:]); err != nil {
+			return err
+		}
+	}
+	if _, err := r.Discard(int((n - (size + 3)) * g1Bytes)); err != nil {
+		return err
+	}
+	srs.Vk.G1 = srs.Pk.G1[0]
+	return nil
+}
+
+func readTauG2(r *bufio.Reader, sectionSize uint64, srs *kzg.SRS) error {
+	const g2Bytes = 128 // snarkjs serializes BN254 G2 points as four 32-byte field elements
+	if sectionSize < 2*g2Bytes {
+		return fmt.Errorf("tauG2 section too small: %d bytes", sectionSize)
+	}
+	buf := make([]byte, g2Bytes)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return err
+	}
+	if err := srs.Vk.G2[0].X.A0.SetBytesCanonical(buf[:32]); err != nil {
+		return err
+	}
+	if err := srs.Vk.G2[0].X.A1.SetBytesCanonical(buf[32:64]); err != nil {
+		return err
+	}
+	if err := srs.Vk.G2[0].Y.A0.SetBytesCanonical(buf[64:96]); err != nil {
+		return err
+	}
+	if err := srs.Vk.G2[0].Y.A1.SetBytesCanonical(buf[96:]); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return err
+	}
+	if err := srs.Vk.G2[1].X.A0.SetBytesCanonical(buf[:32]); err != nil {
+		return err
+	}
+	if err := srs.Vk.G2[1].X.A1.SetBytesCanonical(buf[32:64]); err != nil {
+		return err
+	}
+	if err := srs.Vk.G2[1].Y.A0.SetBytesCanonical(buf[64:96]); err != nil {
+		return err
+	}
+	if err := srs.Vk.G2[1].Y.A1.SetBytesCanonical(buf[96:]); err != nil {
+		return err
+	}
+	if _, err := r.Discard(int(sectionSize - 2*g2Bytes)); err != nil {
+		return err
+	}
+	return nil
+}